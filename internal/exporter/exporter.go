@@ -0,0 +1,139 @@
+// Package exporter serves the same metrics MonitorView renders as
+// Prometheus text-format gauges and counters, via an embedded HTTP server.
+package exporter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"redis-cli-dashboard/internal/redis"
+)
+
+// Exporter caches one GetMetrics()/INFO round-trip per tick so the TUI and
+// any concurrent Prometheus scrape share a single call to Redis.
+type Exporter struct {
+	redis   *redis.Client
+	cluster *redis.ClusterClient
+	ttl     time.Duration
+
+	mu       sync.Mutex
+	cached   *redis.Metrics
+	cachedAt time.Time
+}
+
+// New creates an Exporter that re-scrapes INFO at most once per ttl.
+func New(client *redis.Client, ttl time.Duration) *Exporter {
+	return &Exporter{redis: client, ttl: ttl}
+}
+
+// WithCluster adds per-node gauges to the scrape output, sourced from cc.
+func (e *Exporter) WithCluster(cc *redis.ClusterClient) *Exporter {
+	e.cluster = cc
+	return e
+}
+
+// Metrics returns the cached metrics, re-scraping GetMetrics() if the cache
+// has gone stale.
+func (e *Exporter) Metrics() (*redis.Metrics, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cached != nil && time.Since(e.cachedAt) < e.ttl {
+		return e.cached, nil
+	}
+
+	metrics, err := e.redis.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	e.cached = metrics
+	e.cachedAt = time.Now()
+
+	return metrics, nil
+}
+
+// ServeHTTP renders the cached metrics (and, if clustered, per-node
+// metrics) as Prometheus text format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m, err := e.Metrics()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	var b strings.Builder
+	writeGauge(&b, "redis_connected_clients", "Number of client connections", nil, float64(m.ConnectedClients))
+	writeGauge(&b, "redis_used_memory_bytes", "Used memory in bytes", nil, float64(m.UsedMemory))
+	writeCounter(&b, "redis_commands_total", "Total commands processed", nil, float64(m.TotalCommandsProcessed))
+	writeCounter(&b, "redis_keyspace_hits_total", "Keyspace hits", nil, float64(m.KeyspaceHits))
+	writeCounter(&b, "redis_keyspace_misses_total", "Keyspace misses", nil, float64(m.KeyspaceMisses))
+	writeGauge(&b, "redis_hit_ratio", "Keyspace hit ratio", nil, hitRatio(m))
+
+	if e.cluster != nil {
+		if nodes, err := e.cluster.NodeMetrics(r.Context()); err == nil {
+			for _, node := range nodes {
+				if node.Err != nil {
+					continue
+				}
+				labels := map[string]string{"node": node.Addr, "role": node.Role}
+				writeGauge(&b, "redis_connected_clients", "Number of client connections", labels, float64(node.Metrics.ConnectedClients))
+				writeGauge(&b, "redis_used_memory_bytes", "Used memory in bytes", labels, float64(node.Metrics.UsedMemory))
+				writeGauge(&b, "redis_hit_ratio", "Keyspace hit ratio", labels, hitRatio(node.Metrics))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	fmt.Fprint(w, b.String())
+}
+
+// ListenAndServe starts the embedded HTTP server at addr, serving /metrics.
+func (e *Exporter) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	return http.ListenAndServe(addr, mux)
+}
+
+// hitRatio computes the keyspace hit ratio, guarding against a zero denominator.
+func hitRatio(m *redis.Metrics) float64 {
+	if m.KeyspaceHits+m.KeyspaceMisses == 0 {
+		return 0
+	}
+	return float64(m.KeyspaceHits) / float64(m.KeyspaceHits+m.KeyspaceMisses)
+}
+
+// writeGauge appends a Prometheus gauge sample, with optional labels.
+func writeGauge(b *strings.Builder, name, help string, labels map[string]string, value float64) {
+	writeMetric(b, name, help, "gauge", labels, value)
+}
+
+// writeCounter appends a Prometheus counter sample, with optional labels.
+func writeCounter(b *strings.Builder, name, help string, labels map[string]string, value float64) {
+	writeMetric(b, name, help, "counter", labels, value)
+}
+
+func writeMetric(b *strings.Builder, name, help, metricType string, labels map[string]string, value float64) {
+	// HELP and TYPE may appear only once per metric name, so per-node
+	// samples of an already written metric get just the sample line.
+	if !strings.Contains(b.String(), "# TYPE "+name+" ") {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+	}
+	fmt.Fprintf(b, "%s%s %g\n", name, labelString(labels), value)
+}
+
+func labelString(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, v))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
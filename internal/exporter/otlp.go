@@ -0,0 +1,87 @@
+//go:build otlp
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// OTLPExporter periodically pushes a subset of the metrics ServeHTTP renders
+// (connected clients, used memory, commands processed, and hit ratio) to an
+// OTLP collector, as an alternative sink to the pull-based /metrics endpoint.
+// Keyspace hit/miss counters and per-node series are not pushed.
+type OTLPExporter struct {
+	exporter *Exporter
+	reader   *sdkmetric.PeriodicReader
+
+	connectedClients metric.Int64ObservableGauge
+	usedMemory       metric.Int64ObservableGauge
+	commandsTotal    metric.Int64ObservableCounter
+	hitRatio         metric.Float64ObservableGauge
+}
+
+// NewOTLPExporter builds an OTLP push exporter sending to collectorAddr
+// every interval.
+func NewOTLPExporter(ctx context.Context, e *Exporter, collectorAddr string, interval time.Duration) (*OTLPExporter, error) {
+	client, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpoint(collectorAddr), otlpmetrichttp.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP client: %w", err)
+	}
+
+	reader := sdkmetric.NewPeriodicReader(client, sdkmetric.WithInterval(interval))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter := provider.Meter("redis-cli-dashboard")
+
+	o := &OTLPExporter{exporter: e, reader: reader}
+	if err := o.registerInstruments(meter); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+func (o *OTLPExporter) registerInstruments(meter metric.Meter) error {
+	var err error
+
+	if o.connectedClients, err = meter.Int64ObservableGauge("redis_connected_clients"); err != nil {
+		return err
+	}
+	if o.usedMemory, err = meter.Int64ObservableGauge("redis_used_memory_bytes"); err != nil {
+		return err
+	}
+	if o.commandsTotal, err = meter.Int64ObservableCounter("redis_commands_total"); err != nil {
+		return err
+	}
+	if o.hitRatio, err = meter.Float64ObservableGauge("redis_hit_ratio"); err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(o.observe,
+		o.connectedClients, o.usedMemory, o.commandsTotal, o.hitRatio)
+	return err
+}
+
+func (o *OTLPExporter) observe(ctx context.Context, obs metric.Observer) error {
+	m, err := o.exporter.Metrics()
+	if err != nil {
+		return err
+	}
+
+	obs.ObserveInt64(o.connectedClients, m.ConnectedClients)
+	obs.ObserveInt64(o.usedMemory, m.UsedMemory)
+	obs.ObserveInt64(o.commandsTotal, m.TotalCommandsProcessed)
+	obs.ObserveFloat64(o.hitRatio, hitRatio(m))
+
+	return nil
+}
+
+// Shutdown flushes and stops the OTLP reader.
+func (o *OTLPExporter) Shutdown(ctx context.Context) error {
+	return o.reader.Shutdown(ctx)
+}
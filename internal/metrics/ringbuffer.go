@@ -0,0 +1,64 @@
+// Package metrics provides small in-memory helpers for rendering rolling
+// time-series data in the TUI, such as the monitor view's sparklines.
+package metrics
+
+// RingBuffer is a fixed-capacity, O(1)-push buffer of float64 samples.
+// Pushing past capacity overwrites the oldest sample; Values always
+// returns samples oldest-to-newest.
+type RingBuffer struct {
+	data  []float64
+	next  int
+	count int
+}
+
+// NewRingBuffer creates a RingBuffer holding up to capacity samples.
+func NewRingBuffer(capacity int) *RingBuffer {
+	return &RingBuffer{data: make([]float64, capacity)}
+}
+
+// Push appends a sample, evicting the oldest one once the buffer is full.
+func (r *RingBuffer) Push(v float64) {
+	r.data[r.next] = v
+	r.next = (r.next + 1) % len(r.data)
+	if r.count < len(r.data) {
+		r.count++
+	}
+}
+
+// Values returns every stored sample in insertion order.
+func (r *RingBuffer) Values() []float64 {
+	values := make([]float64, r.count)
+
+	start := r.next - r.count
+	if start < 0 {
+		start += len(r.data)
+	}
+
+	for i := 0; i < r.count; i++ {
+		values[i] = r.data[(start+i)%len(r.data)]
+	}
+
+	return values
+}
+
+// Stats returns the min, max, and average of the stored samples.
+func (r *RingBuffer) Stats() (min, max, avg float64) {
+	values := r.Values()
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+
+	min, max = values[0], values[0]
+	sum := 0.0
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		sum += v
+	}
+
+	return min, max, sum / float64(len(values))
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import "sync"
+
+// Store holds one RingBuffer per metric name, so a view can push a new
+// sample for "ops", "mem", etc. each tick without pre-declaring every
+// series up front.
+type Store struct {
+	mu       sync.Mutex
+	capacity int
+	buffers  map[string]*RingBuffer
+}
+
+// NewStore creates a Store whose RingBuffers each hold capacity samples.
+func NewStore(capacity int) *Store {
+	return &Store{capacity: capacity, buffers: make(map[string]*RingBuffer)}
+}
+
+// Push records a sample for name, creating its RingBuffer on first use.
+func (s *Store) Push(name string, v float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rb, ok := s.buffers[name]
+	if !ok {
+		rb = NewRingBuffer(s.capacity)
+		s.buffers[name] = rb
+	}
+
+	rb.Push(v)
+}
+
+// Get returns the RingBuffer for name, or nil if nothing has been pushed yet.
+func (s *Store) Get(name string) *RingBuffer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.buffers[name]
+}
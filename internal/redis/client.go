@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Client wraps a go-redis client with the helpers the dashboard views need.
+type Client struct {
+	rdb  *goredis.Client
+	opts *goredis.Options
+}
+
+// Metrics holds the subset of INFO fields the dashboard renders.
+type Metrics struct {
+	ConnectedClients       int64
+	UsedMemory             int64
+	UsedMemoryRss          int64
+	TotalCommandsProcessed int64
+	InstantaneousOpsPerSec int64
+	KeyspaceHits           int64
+	KeyspaceMisses         int64
+}
+
+// NewClient connects to a single Redis instance at addr.
+func NewClient(addr, password string, db int) (*Client, error) {
+	opts := &goredis.Options{Addr: addr, Password: password, DB: db}
+	rdb := goredis.NewClient(opts)
+
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("connecting to redis: %w", err)
+	}
+
+	return &Client{rdb: rdb, opts: opts}, nil
+}
+
+// Info runs INFO and returns every field as a string-keyed map.
+func (c *Client) Info(sections ...string) (map[string]interface{}, error) {
+	raw, err := c.rdb.Info(context.Background(), sections...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("running INFO: %w", err)
+	}
+
+	info := make(map[string]interface{})
+	for _, line := range strings.Split(raw, "\r\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		info[parts[0]] = parts[1]
+	}
+
+	return info, nil
+}
+
+// GetMetrics collects the headline metrics shown on the monitoring view.
+func (c *Client) GetMetrics() (*Metrics, error) {
+	info, err := c.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		ConnectedClients:       infoInt(info, "connected_clients"),
+		UsedMemory:             infoInt(info, "used_memory"),
+		UsedMemoryRss:          infoInt(info, "used_memory_rss"),
+		TotalCommandsProcessed: infoInt(info, "total_commands_processed"),
+		InstantaneousOpsPerSec: infoInt(info, "instantaneous_ops_per_sec"),
+		KeyspaceHits:           infoInt(info, "keyspace_hits"),
+		KeyspaceMisses:         infoInt(info, "keyspace_misses"),
+	}, nil
+}
+
+// ClusterNodes runs CLUSTER NODES and returns the raw output.
+func (c *Client) ClusterNodes() (string, error) {
+	return c.rdb.ClusterNodes(context.Background()).Result()
+}
+
+// infoInt parses an INFO field as an integer, defaulting to 0 when the
+// field is missing or not numeric.
+func infoInt(info map[string]interface{}, key string) int64 {
+	v, ok := info[key]
+	if !ok {
+		return 0
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return 0
+	}
+
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
@@ -0,0 +1,139 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentNodeInfo bounds how many per-node INFO calls run at once so a
+// large cluster doesn't open a burst of connections on every tick.
+const maxConcurrentNodeInfo = 8
+
+// NodeInfo holds the per-node metrics rendered for one row of the cluster
+// table.
+type NodeInfo struct {
+	ID        string
+	Addr      string
+	Role      string
+	LinkState string
+	Metrics   *Metrics
+	Err       error
+}
+
+// ClusterClient fans metrics collection out across every node in a Redis
+// Cluster. It mirrors the routing model go-redis's ClusterClient uses: one
+// *Client per node address, opened lazily and cached for reuse.
+type ClusterClient struct {
+	seed *Client
+
+	mu    sync.Mutex
+	nodes map[string]*Client
+}
+
+// NewClusterClient wraps an existing single-node Client used to discover
+// the cluster topology via CLUSTER NODES.
+func NewClusterClient(seed *Client) *ClusterClient {
+	return &ClusterClient{seed: seed, nodes: make(map[string]*Client)}
+}
+
+// NodeMetrics runs CLUSTER NODES against the seed client, then INFO against
+// every node concurrently (bounded to maxConcurrentNodeInfo in flight), and
+// returns one NodeInfo per cluster member.
+func (cc *ClusterClient) NodeMetrics(ctx context.Context) ([]NodeInfo, error) {
+	raw, err := cc.seed.ClusterNodes()
+	if err != nil {
+		return nil, fmt.Errorf("running CLUSTER NODES: %w", err)
+	}
+
+	var (
+		mu      sync.Mutex
+		results []NodeInfo
+	)
+
+	g, _ := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentNodeInfo)
+
+	for _, line := range strings.Split(raw, "\n") {
+		line := strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 8 {
+			continue
+		}
+
+		id := fields[0]
+		addr := strings.Split(fields[1], "@")[0]
+		flags := fields[2]
+		linkState := fields[7]
+
+		role := "slave"
+		if strings.Contains(flags, "master") {
+			role = "master"
+		}
+
+		g.Go(func() error {
+			node := NodeInfo{ID: id, Addr: addr, Role: role, LinkState: linkState}
+
+			client, err := cc.clientFor(addr)
+			if err != nil {
+				node.Err = err
+			} else if metrics, err := client.GetMetrics(); err != nil {
+				node.Err = err
+			} else {
+				node.Metrics = metrics
+			}
+
+			mu.Lock()
+			results = append(results, node)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Addr < results[j].Addr })
+
+	return results, nil
+}
+
+// clientFor returns the cached *Client for addr, opening and caching a new
+// one on first use.
+func (cc *ClusterClient) clientFor(addr string) (*Client, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if client, ok := cc.nodes[addr]; ok {
+		return client, nil
+	}
+
+	client, err := NewClient(addr, cc.seed.opts.Password, cc.seed.opts.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.nodes[addr] = client
+	return client, nil
+}
+
+// Close closes every cached per-node client.
+func (cc *ClusterClient) Close() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	for addr, client := range cc.nodes {
+		client.rdb.Close()
+		delete(cc.nodes, addr)
+	}
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// LatencyEvent is one row of LATENCY LATEST: the event name, the unix time
+// of its latest spike (LastSample), that spike's latency in milliseconds
+// (LastMaxMS), and its all-time-max latency in milliseconds (MaxMS).
+type LatencyEvent struct {
+	Name       string
+	LastSample int64
+	LastMaxMS  int64
+	MaxMS      int64
+}
+
+// LatencySample is one (unix-timestamp, latency-ms) pair from LATENCY HISTORY.
+type LatencySample struct {
+	At        int64
+	LatencyMS int64
+}
+
+// LatencyLatest runs LATENCY LATEST and returns one LatencyEvent per
+// tracked event.
+func (c *Client) LatencyLatest(ctx context.Context) ([]LatencyEvent, error) {
+	res, err := c.rdb.Do(ctx, "LATENCY", "LATEST").Result()
+	if err != nil {
+		return nil, fmt.Errorf("running LATENCY LATEST: %w", err)
+	}
+
+	rows, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected LATENCY LATEST reply type %T", res)
+	}
+
+	events := make([]LatencyEvent, 0, len(rows))
+	for _, row := range rows {
+		fields, ok := row.([]interface{})
+		if !ok || len(fields) < 4 {
+			continue
+		}
+
+		events = append(events, LatencyEvent{
+			Name:       fmt.Sprintf("%v", fields[0]),
+			LastSample: toInt64(fields[1]),
+			LastMaxMS:  toInt64(fields[2]),
+			MaxMS:      toInt64(fields[3]),
+		})
+	}
+
+	return events, nil
+}
+
+// LatencyHistory runs LATENCY HISTORY <event> and returns its samples.
+func (c *Client) LatencyHistory(ctx context.Context, event string) ([]LatencySample, error) {
+	res, err := c.rdb.Do(ctx, "LATENCY", "HISTORY", event).Result()
+	if err != nil {
+		return nil, fmt.Errorf("running LATENCY HISTORY %s: %w", event, err)
+	}
+
+	rows, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected LATENCY HISTORY reply type %T", res)
+	}
+
+	samples := make([]LatencySample, 0, len(rows))
+	for _, row := range rows {
+		fields, ok := row.([]interface{})
+		if !ok || len(fields) < 2 {
+			continue
+		}
+
+		samples = append(samples, LatencySample{At: toInt64(fields[0]), LatencyMS: toInt64(fields[1])})
+	}
+
+	return samples, nil
+}
+
+// Percentile returns the p-th percentile (0-100) latency in milliseconds
+// across samples. Samples need not be pre-sorted. Returns 0 for an empty
+// slice.
+func Percentile(samples []LatencySample, p float64) int64 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	values := make([]int64, len(samples))
+	for i, s := range samples {
+		values[i] = s.LatencyMS
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	idx := int(math.Ceil(p/100*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	} else if idx >= len(values) {
+		idx = len(values) - 1
+	}
+
+	return values[idx]
+}
+
+// LatencyReset runs LATENCY RESET, clearing every tracked latency event.
+func (c *Client) LatencyReset(ctx context.Context) error {
+	return c.rdb.Do(ctx, "LATENCY", "RESET").Err()
+}
+
+// toInt64 coerces a RESP reply field (int64 or bulk string) into an int64.
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	default:
+		return 0
+	}
+}
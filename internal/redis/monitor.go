@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Monitor opens a dedicated connection, issues MONITOR on it, and streams
+// every line Redis reports until the returned cancel func is called. The
+// channel is buffered so a slow consumer can't stall the connection; once
+// it's full, new lines are dropped rather than blocking the reader.
+func (c *Client) Monitor(ctx context.Context) (<-chan string, func(), error) {
+	mc := goredis.NewClient(c.opts)
+	monitorCtx, cancel := context.WithCancel(ctx)
+
+	raw := make(chan string)
+	cmd := mc.Monitor(monitorCtx, raw)
+	if err := cmd.Start(); err != nil {
+		cancel()
+		mc.Close()
+		return nil, nil, err
+	}
+
+	lines := make(chan string, 256)
+
+	go func() {
+		defer close(lines)
+		for {
+			select {
+			case line, ok := <-raw:
+				if !ok {
+					return
+				}
+				select {
+				case lines <- line:
+				default:
+					// Consumer can't keep up; drop the line rather than block MONITOR.
+				}
+			case <-monitorCtx.Done():
+				return
+			}
+		}
+	}()
+
+	stop := func() {
+		cancel()
+		mc.Close()
+	}
+
+	return lines, stop, nil
+}
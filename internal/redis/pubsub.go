@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// PubSubMessage is one message received from a PSUBSCRIBE pattern.
+type PubSubMessage struct {
+	Pattern string
+	Channel string
+	Payload string
+}
+
+// PSubscribe subscribes to patterns and streams messages until ctx is
+// done, resubscribing with exponential backoff if the connection drops.
+func (c *Client) PSubscribe(ctx context.Context, patterns ...string) (<-chan PubSubMessage, error) {
+	out := make(chan PubSubMessage, 256)
+
+	go func() {
+		defer close(out)
+
+		backoff := time.Second
+		for ctx.Err() == nil {
+			pubsub := c.rdb.PSubscribe(ctx, patterns...)
+			ch := pubsub.Channel()
+
+		drain:
+			for {
+				select {
+				case msg, ok := <-ch:
+					if !ok {
+						break drain
+					}
+					backoff = time.Second
+					select {
+					case out <- PubSubMessage{Pattern: msg.Pattern, Channel: msg.Channel, Payload: msg.Payload}:
+					default:
+						// Consumer can't keep up; drop rather than block the subscription.
+					}
+				case <-ctx.Done():
+					pubsub.Close()
+					return
+				}
+			}
+			pubsub.Close()
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			if backoff < 30*time.Second {
+				backoff *= 2
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,173 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// haEventChannels are the Sentinel pub/sub channels the HA event feed
+// surfaces; every other channel is dropped client-side.
+var haEventChannels = map[string]bool{
+	"+switch-master": true,
+	"+sdown":         true,
+	"+odown":         true,
+	"+failover-end":  true,
+	"+slave":         true,
+}
+
+// SentinelEvent is one HA event received over a Sentinel pub/sub channel.
+type SentinelEvent struct {
+	Channel string
+	Payload string
+	At      time.Time
+}
+
+// MasterStatus is a row of `SENTINEL masters` / `SENTINEL slaves <name>`.
+type MasterStatus struct {
+	Name       string
+	Addr       string
+	NumSlaves  int
+	Quorum     int
+	LastOKPing int64
+}
+
+// SentinelClient talks to a pool of Redis Sentinels watching masterName,
+// rotating to the next sentinel on failure the same way go-redis's
+// failover client does.
+type SentinelClient struct {
+	masterName string
+	clients    []*goredis.SentinelClient
+
+	mu      sync.Mutex
+	current int
+}
+
+// NewSentinelClient connects to every sentinel in addrs, watching masterName.
+func NewSentinelClient(masterName string, sentinelAddrs []string, password string) (*SentinelClient, error) {
+	if len(sentinelAddrs) == 0 {
+		return nil, fmt.Errorf("no sentinel addresses configured")
+	}
+
+	clients := make([]*goredis.SentinelClient, len(sentinelAddrs))
+	for i, addr := range sentinelAddrs {
+		clients[i] = goredis.NewSentinelClient(&goredis.Options{Addr: addr, Password: password})
+	}
+
+	return &SentinelClient{masterName: masterName, clients: clients}, nil
+}
+
+// active returns the sentinel client currently in rotation.
+func (s *SentinelClient) active() *goredis.SentinelClient {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clients[s.current]
+}
+
+// rotate advances to the next sentinel in the list after a failure, the
+// same way go-redis's failover client falls back to the next sentinel.
+func (s *SentinelClient) rotate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = (s.current + 1) % len(s.clients)
+}
+
+// Topology polls SENTINEL MASTER <name> and SENTINEL SLAVES <name> on the
+// active sentinel and returns the master plus its replicas.
+func (s *SentinelClient) Topology(ctx context.Context) ([]MasterStatus, error) {
+	client := s.active()
+
+	master, err := client.Master(ctx, s.masterName).Result()
+	if err != nil {
+		s.rotate()
+		return nil, fmt.Errorf("running SENTINEL MASTER: %w", err)
+	}
+
+	statuses := []MasterStatus{parseMasterStatus(master)}
+
+	slaves, err := client.Slaves(ctx, s.masterName).Result()
+	if err != nil {
+		return statuses, fmt.Errorf("running SENTINEL slaves: %w", err)
+	}
+
+	for _, slave := range slaves {
+		kv, ok := slave.([]interface{})
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, parseMasterStatus(fieldsFromKV(kv)))
+	}
+
+	return statuses, nil
+}
+
+// Events PSUBSCRIBEs to "*" on every configured sentinel and streams the
+// subset of messages matching haEventChannels until ctx is done.
+func (s *SentinelClient) Events(ctx context.Context) <-chan SentinelEvent {
+	events := make(chan SentinelEvent, 64)
+
+	for _, client := range s.clients {
+		client := client
+		pubsub := client.PSubscribe(ctx, "*")
+
+		go func() {
+			defer pubsub.Close()
+			ch := pubsub.Channel()
+			for {
+				select {
+				case msg, ok := <-ch:
+					if !ok {
+						return
+					}
+					if !haEventChannels[msg.Channel] {
+						continue
+					}
+					events <- SentinelEvent{Channel: msg.Channel, Payload: msg.Payload, At: time.Now()}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	return events
+}
+
+// parseMasterStatus converts a SENTINEL masters/slaves reply into a
+// MasterStatus row.
+func parseMasterStatus(fields map[string]string) MasterStatus {
+	return MasterStatus{
+		Name:       fields["name"],
+		Addr:       fmt.Sprintf("%s:%s", fields["ip"], fields["port"]),
+		NumSlaves:  atoiSafe(fields["num-slaves"]),
+		Quorum:     atoiSafe(fields["quorum"]),
+		LastOKPing: int64(atoiSafe(fields["last-ok-ping-reply"])),
+	}
+}
+
+// atoiSafe parses s as an int, defaulting to 0 on error.
+func atoiSafe(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// fieldsFromKV converts the flat []interface{} of alternating field/value
+// strings that SENTINEL SLAVES returns per-element (go-redis only maps the
+// singular SENTINEL MASTER reply to map[string]string) into the same shape
+// parseMasterStatus expects.
+func fieldsFromKV(kv []interface{}) map[string]string {
+	fields := make(map[string]string, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			continue
+		}
+		value, _ := kv[i+1].(string)
+		fields[key] = value
+	}
+	return fields
+}
@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+// SlowLogEntry is one row from SLOWLOG GET.
+type SlowLogEntry struct {
+	ID         int64
+	Timestamp  int64
+	DurationUS int64
+	Args       []string
+	ClientAddr string
+	ClientName string
+}
+
+// SlowLogGet runs SLOWLOG GET <count> and returns the most recent entries.
+func (c *Client) SlowLogGet(ctx context.Context, count int64) ([]SlowLogEntry, error) {
+	raw, err := c.rdb.SlowLogGet(ctx, count).Result()
+	if err != nil {
+		return nil, fmt.Errorf("running SLOWLOG GET: %w", err)
+	}
+
+	entries := make([]SlowLogEntry, 0, len(raw))
+	for _, e := range raw {
+		entries = append(entries, SlowLogEntry{
+			ID:         e.ID,
+			Timestamp:  e.Time.Unix(),
+			DurationUS: e.Duration.Microseconds(),
+			Args:       e.Args,
+			ClientAddr: e.ClientAddr,
+			ClientName: e.ClientName,
+		})
+	}
+
+	return entries, nil
+}
+
+// SlowLogReset runs SLOWLOG RESET, clearing the slow log.
+func (c *Client) SlowLogReset(ctx context.Context) error {
+	return c.rdb.SlowLogReset(ctx).Err()
+}
+
+// ConfigGet runs CONFIG GET <parameter> and returns its value.
+func (c *Client) ConfigGet(ctx context.Context, parameter string) (string, error) {
+	res, err := c.rdb.ConfigGet(ctx, parameter).Result()
+	if err != nil {
+		return "", fmt.Errorf("running CONFIG GET %s: %w", parameter, err)
+	}
+
+	return res[parameter], nil
+}
+
+// ConfigSet runs CONFIG SET <parameter> <value>.
+func (c *Client) ConfigSet(ctx context.Context, parameter, value string) error {
+	return c.rdb.ConfigSet(ctx, parameter, value).Err()
+}
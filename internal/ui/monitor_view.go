@@ -1,10 +1,18 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"redis-cli-dashboard/internal/exporter"
 	"redis-cli-dashboard/internal/logger"
+	"redis-cli-dashboard/internal/metrics"
 	"redis-cli-dashboard/internal/redis"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -12,6 +20,46 @@ import (
 	"github.com/rivo/tview"
 )
 
+// monitorStreamDuration is the default duration for how long a MONITOR
+// command stream session runs before it auto-stops, since MONITOR degrades
+// Redis performance the longer it stays attached. Override it per view with
+// WithMonitorStreamDuration.
+const monitorStreamDuration = 2 * time.Minute
+
+// monitorStreamMaxLines bounds how many MONITOR lines are kept on screen.
+const monitorStreamMaxLines = 500
+
+// haEventScrollback bounds how many Sentinel HA events are kept on screen.
+const haEventScrollback = 100
+
+// chartHistory bounds how many samples each metrics.RingBuffer keeps.
+const chartHistory = 60
+
+// chartOrder is the cycle order for the primary chart keybind.
+var chartOrder = []string{"ops", "mem", "net_in", "net_out", "p99"}
+
+// chartLabels are the display names for chartOrder entries.
+var chartLabels = map[string]string{
+	"ops":     "Ops/sec",
+	"mem":     "Used Memory",
+	"net_in":  "Net In (kbps)",
+	"net_out": "Net Out (kbps)",
+	"p99":     "P99 Latency (ms)",
+}
+
+// defaultSlowlogPollInterval is how often the slow-log subview scrapes
+// SLOWLOG GET on its own timer, independent of the main 1s metrics ticker.
+const defaultSlowlogPollInterval = 5 * time.Second
+
+// slowlogFetchCount is how many recent entries SLOWLOG GET returns.
+const slowlogFetchCount = 128
+
+// eventScrollback bounds how many keyspace-notification events are kept.
+const eventScrollback = 500
+
+// eventCountWindow is the sliding window used for per-event-type counters.
+const eventCountWindow = 60 * time.Second
+
 // MonitorView represents the monitoring view
 type MonitorView struct {
 	redis     *redis.Client
@@ -21,14 +69,100 @@ type MonitorView struct {
 	monitoring bool
 	ticker     *time.Ticker
 	stopChan   chan bool
+
+	// Command stream state (MONITOR). streamMu guards streamLines,
+	// streamFilter, streamDeadline, filterEditing, and filterBuf: the
+	// MONITOR-reading goroutine appends lines while the input-handling
+	// goroutine renders and edits the filter concurrently.
+	streaming      bool
+	streamCancel   func()
+	streamDuration time.Duration
+	streamMu       sync.Mutex
+	streamLines    []string
+	streamFilter   *regexp.Regexp
+	streamDeadline time.Time
+	filterEditing  bool
+	filterBuf      string
+
+	// Cluster fan-out state. nodeMu guards nodeInfos/selectedNode/
+	// nodeDetailOpen: the metrics-ticker goroutine (getClusterNodesTable)
+	// writes nodeInfos and reads the other two back for rendering, while
+	// the input-handling goroutine (handleInput/moveNodeSelection) writes
+	// selectedNode/nodeDetailOpen concurrently.
+	clusterClient  *redis.ClusterClient
+	nodeMu         sync.Mutex
+	nodeInfos      []redis.NodeInfo
+	selectedNode   int
+	nodeDetailOpen bool
+
+	// Sentinel / HA state
+	sentinel     *redis.SentinelClient
+	haCancel     func()
+	haEvents     []string
+	haTopologyMu sync.Mutex
+
+	// Chart state
+	charts       *metrics.Store
+	primaryChart string
+
+	// Shared metrics cache; when set, loadMetrics goes through the same
+	// INFO cache a Prometheus scrape on exp.ServeHTTP would hit, instead of
+	// calling GetMetrics on its own ticker.
+	exp *exporter.Exporter
+
+	// Slow-log subview state
+	pages        *tview.Pages
+	slowlogTable *tview.Table
+	slowlogMode  bool
+	// slowlogMu guards slowlogEntries and slowlogSortDesc: refreshSlowlog
+	// runs on the slow-log poll goroutine while showSlowlogDetail and the
+	// 'd' sort toggle run on the input-handling goroutine.
+	slowlogMu        sync.Mutex
+	slowlogEntries   []redis.SlowLogEntry
+	slowlogSortDesc  bool
+	slowlogInterval  time.Duration
+	slowlogTicker    *time.Ticker
+	slowlogStopChan  chan bool
+	thresholdEditing bool
+	thresholdBuf     string
+
+	// Keyspace-notifications "Events" panel state. eventsMu guards every
+	// field below it: handleEventMessage runs on the pub/sub goroutine
+	// while the rest are read and written from the input-handling and
+	// render goroutines.
+	eventsEnabled    bool
+	eventsPrevValue  string
+	eventsCancel     func()
+	eventsMu         sync.Mutex
+	eventsPaused     bool
+	eventGlob        string
+	eventGlobEditing bool
+	eventGlobBuf     string
+	eventLines       []eventRecord
+	eventCounts      []eventCountSample
+}
+
+// eventRecord is one rendered row of the Events panel.
+type eventRecord struct {
+	At    time.Time
+	DB    string
+	Event string
+	Key   string
+}
+
+// eventCountSample backs the sliding 60s per-event-type counters.
+type eventCountSample struct {
+	At    time.Time
+	Event string
 }
 
 // NewMonitorView creates a new monitor view
 func NewMonitorView(redisClient *redis.Client) *MonitorView {
 	logger.Logger.Println("Initializing MonitorView...")
 	view := &MonitorView{
-		redis:    redisClient,
-		stopChan: make(chan bool),
+		redis:          redisClient,
+		stopChan:       make(chan bool),
+		streamDuration: monitorStreamDuration,
 	}
 
 	view.setupUI()
@@ -38,6 +172,14 @@ func NewMonitorView(redisClient *redis.Client) *MonitorView {
 	return view
 }
 
+// WithMonitorStreamDuration overrides how long a MONITOR command stream
+// session runs before auto-stopping, in place of the monitorStreamDuration
+// default.
+func (v *MonitorView) WithMonitorStreamDuration(d time.Duration) *MonitorView {
+	v.streamDuration = d
+	return v
+}
+
 // setupUI initializes the UI components
 func (v *MonitorView) setupUI() {
 	v.component = tview.NewTextView().
@@ -50,15 +192,66 @@ func (v *MonitorView) setupUI() {
 	v.component.SetBorder(true).
 		SetTitle("Real-time Monitoring").
 		SetBorderPadding(0, 0, 1, 1)
+
+	v.slowlogTable = tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	v.slowlogTable.SetInputCapture(v.handleSlowlogInput)
+	v.slowlogTable.SetBorder(true).
+		SetTitle("Slow Log").
+		SetBorderPadding(0, 0, 1, 1)
+
+	v.slowlogInterval = defaultSlowlogPollInterval
+
+	v.pages = tview.NewPages().
+		AddPage("metrics", v.component, true, true).
+		AddPage("slowlog", v.slowlogTable, true, false)
+}
+
+// WithExporter routes loadMetrics through exp's cache instead of calling
+// GetMetrics directly, so the TUI and any concurrent Prometheus scrape on
+// exp share a single GetMetrics round-trip per tick. The extra INFO call
+// loadMetrics makes for the fields Metrics does not carry is not shared.
+func (v *MonitorView) WithExporter(exp *exporter.Exporter) *MonitorView {
+	v.exp = exp
+	return v
 }
 
 // GetComponent returns the main component
 func (v *MonitorView) GetComponent() tview.Primitive {
-	return v.component
+	return v.pages
 }
 
 // handleInput handles input for the monitor view
 func (v *MonitorView) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	if v.filterEditing {
+		return v.handleFilterInput(event)
+	}
+	if v.eventGlobEditing {
+		return v.handleEventGlobInput(event)
+	}
+
+	v.nodeMu.Lock()
+	hasNodes := len(v.nodeInfos) > 0
+	v.nodeMu.Unlock()
+
+	if hasNodes {
+		switch event.Key() {
+		case tcell.KeyUp:
+			v.moveNodeSelection(-1)
+			return nil
+		case tcell.KeyDown:
+			v.moveNodeSelection(1)
+			return nil
+		case tcell.KeyEnter:
+			v.nodeMu.Lock()
+			v.nodeDetailOpen = !v.nodeDetailOpen
+			v.nodeMu.Unlock()
+			v.Refresh()
+			return nil
+		}
+	}
+
 	switch event.Rune() {
 	case 's', 'S':
 		v.toggleMonitoring()
@@ -69,12 +262,136 @@ func (v *MonitorView) handleInput(event *tcell.EventKey) *tcell.EventKey {
 	case 'r', 'R':
 		v.Refresh()
 		return nil
+	case 'm', 'M':
+		v.toggleCommandStream()
+		return nil
+	case '[':
+		v.cyclePrimaryChart(-1)
+		return nil
+	case ']':
+		v.cyclePrimaryChart(1)
+		return nil
+	case 'L':
+		v.resetLatencyHistogram()
+		return nil
+	case 'l':
+		v.toggleSlowlogView()
+		return nil
+	case 'k', 'K':
+		v.toggleEvents()
+		return nil
+	case 'p', 'P':
+		if v.eventsEnabled {
+			v.eventsMu.Lock()
+			v.eventsPaused = !v.eventsPaused
+			v.eventsMu.Unlock()
+			v.Refresh()
+		}
+		return nil
+	case 'g':
+		if v.eventsEnabled {
+			v.eventsMu.Lock()
+			v.eventGlobEditing = true
+			v.eventGlobBuf = v.eventGlob
+			v.eventsMu.Unlock()
+			v.Refresh()
+		}
+		return nil
+	case '/':
+		if v.streaming {
+			v.streamMu.Lock()
+			v.filterEditing = true
+			v.filterBuf = ""
+			v.streamMu.Unlock()
+			v.renderStream()
+		}
+		return nil
 	}
 
 	// Let all other keys pass through to global handler (including 1-6, ?, etc.)
 	return event
 }
 
+// handleFilterInput captures keystrokes while the user is typing a filter
+// pattern for the command stream, and compiles it into a regexp on Enter.
+func (v *MonitorView) handleFilterInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEnter:
+		v.streamMu.Lock()
+		v.filterEditing = false
+		if v.filterBuf == "" {
+			v.streamFilter = nil
+		} else if re, err := regexp.Compile(v.filterBuf); err == nil {
+			v.streamFilter = re
+		} else {
+			logger.Logger.Printf("invalid monitor filter %q: %v", v.filterBuf, err)
+		}
+		v.streamMu.Unlock()
+		v.renderStream()
+		return nil
+	case tcell.KeyEscape:
+		v.streamMu.Lock()
+		v.filterEditing = false
+		v.streamMu.Unlock()
+		v.renderStream()
+		return nil
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		v.streamMu.Lock()
+		if len(v.filterBuf) > 0 {
+			v.filterBuf = v.filterBuf[:len(v.filterBuf)-1]
+		}
+		v.streamMu.Unlock()
+		v.renderStream()
+		return nil
+	}
+
+	if event.Rune() != 0 {
+		v.streamMu.Lock()
+		v.filterBuf += string(event.Rune())
+		v.streamMu.Unlock()
+		v.renderStream()
+	}
+
+	return nil
+}
+
+// handleEventGlobInput captures keystrokes while the user is typing a
+// key-glob filter for the Events panel.
+func (v *MonitorView) handleEventGlobInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEnter:
+		v.eventsMu.Lock()
+		v.eventGlobEditing = false
+		v.eventGlob = v.eventGlobBuf
+		v.eventsMu.Unlock()
+		v.Refresh()
+		return nil
+	case tcell.KeyEscape:
+		v.eventsMu.Lock()
+		v.eventGlobEditing = false
+		v.eventsMu.Unlock()
+		v.Refresh()
+		return nil
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		v.eventsMu.Lock()
+		if len(v.eventGlobBuf) > 0 {
+			v.eventGlobBuf = v.eventGlobBuf[:len(v.eventGlobBuf)-1]
+		}
+		v.eventsMu.Unlock()
+		v.Refresh()
+		return nil
+	}
+
+	if event.Rune() != 0 {
+		v.eventsMu.Lock()
+		v.eventGlobBuf += string(event.Rune())
+		v.eventsMu.Unlock()
+		v.Refresh()
+	}
+
+	return nil
+}
+
 // toggleMonitoring starts or stops monitoring
 func (v *MonitorView) toggleMonitoring() {
 	if v.monitoring {
@@ -136,9 +453,199 @@ func (v *MonitorView) clearScreen() {
 	v.component.SetText("")
 }
 
+// toggleCommandStream switches between the metrics dashboard and the live
+// MONITOR command stream.
+func (v *MonitorView) toggleCommandStream() {
+	if v.streaming {
+		v.stopCommandStream()
+		v.Refresh()
+		return
+	}
+
+	v.startCommandStream()
+}
+
+// startCommandStream opens a MONITOR connection and streams commands into
+// the view until v.streamDuration elapses or the user toggles it off.
+// MONITOR attaches to every command Redis processes, which noticeably hurts
+// throughput, so the session is always time-boxed.
+func (v *MonitorView) startCommandStream() {
+	if v.streaming {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), v.streamDuration)
+	lines, stop, err := v.redis.Monitor(ctx)
+	if err != nil {
+		cancel()
+		v.component.SetText(fmt.Sprintf("[red]Error starting MONITOR: %s", err))
+		return
+	}
+
+	v.streaming = true
+	v.streamMu.Lock()
+	v.streamLines = nil
+	v.streamDeadline = time.Now().Add(v.streamDuration)
+	v.streamMu.Unlock()
+	v.streamCancel = func() {
+		stop()
+		cancel()
+	}
+
+	go func() {
+		for line := range lines {
+			line := line
+			v.appendStreamLine(line)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		v.stopCommandStream()
+	}()
+
+	v.renderStream()
+}
+
+// stopCommandStream cancels the MONITOR connection, if any.
+func (v *MonitorView) stopCommandStream() {
+	if !v.streaming {
+		return
+	}
+
+	v.streaming = false
+	if v.streamCancel != nil {
+		v.streamCancel()
+		v.streamCancel = nil
+	}
+}
+
+// appendStreamLine records a MONITOR line and re-renders the view.
+func (v *MonitorView) appendStreamLine(line string) {
+	v.streamMu.Lock()
+	v.streamLines = append(v.streamLines, line)
+	if len(v.streamLines) > monitorStreamMaxLines {
+		v.streamLines = v.streamLines[len(v.streamLines)-monitorStreamMaxLines:]
+	}
+	v.streamMu.Unlock()
+	v.renderStream()
+}
+
+// renderStream redraws the command stream view: a persistent performance
+// warning, the active filter (if any), and every line that still matches it.
+func (v *MonitorView) renderStream() {
+	v.streamMu.Lock()
+	lines := append([]string(nil), v.streamLines...)
+	filter := v.streamFilter
+	deadline := v.streamDeadline
+	filterEditing := v.filterEditing
+	filterBuf := v.filterBuf
+	v.streamMu.Unlock()
+
+	var b strings.Builder
+
+	remaining := time.Until(deadline).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	fmt.Fprintf(&b, "[red::b]MONITOR degrades Redis performance — auto-stopping in %s[-:-:-]\n", remaining)
+
+	if filterEditing {
+		fmt.Fprintf(&b, "[yellow]/%s[white]\n", filterBuf)
+	} else if filter != nil {
+		fmt.Fprintf(&b, "[yellow]filter: /%s/[white]\n", filter.String())
+	}
+	b.WriteString("\n")
+
+	for _, line := range lines {
+		if filter != nil && !filter.MatchString(line) {
+			continue
+		}
+		b.WriteString(colorizeMonitorLine(line))
+		b.WriteString("\n")
+	}
+
+	v.component.SetText(b.String())
+	v.component.ScrollToEnd()
+}
+
+// colorizeMonitorLine color-codes a MONITOR line by its command group:
+// writes red, admin yellow, and everything else green, since commandGroup
+// counts any command that is not a listed write or admin command as a read.
+func colorizeMonitorLine(line string) string {
+	command := monitorCommand(line)
+	switch commandGroup(command) {
+	case "write":
+		return "[red]" + line + "[white]"
+	case "read":
+		return "[green]" + line + "[white]"
+	default:
+		return "[yellow]" + line + "[white]"
+	}
+}
+
+// monitorCommand extracts the command name from a raw MONITOR line, which
+// looks like: 1700000000.123456 [0 127.0.0.1:51234] "SET" "foo" "bar"
+func monitorCommand(line string) string {
+	idx := strings.Index(line, "\"")
+	if idx == -1 {
+		return ""
+	}
+
+	rest := line[idx+1:]
+	end := strings.Index(rest, "\"")
+	if end == -1 {
+		return rest
+	}
+
+	return strings.ToUpper(rest[:end])
+}
+
+var writeCommands = map[string]bool{
+	"SET": true, "SETEX": true, "SETNX": true, "APPEND": true, "DEL": true,
+	"EXPIRE": true, "INCR": true, "INCRBY": true, "DECR": true, "DECRBY": true,
+	"LPUSH": true, "RPUSH": true, "LPOP": true, "RPOP": true, "SADD": true,
+	"SREM": true, "ZADD": true, "ZREM": true, "HSET": true, "HDEL": true,
+	"FLUSHDB": true, "FLUSHALL": true, "RENAME": true, "MSET": true,
+}
+
+var adminCommands = map[string]bool{
+	"CONFIG": true, "CLUSTER": true, "CLIENT": true, "SHUTDOWN": true,
+	"SLAVEOF": true, "REPLICAOF": true, "BGSAVE": true, "BGREWRITEAOF": true,
+	"SAVE": true, "MONITOR": true, "SLOWLOG": true, "DEBUG": true,
+}
+
+// commandGroup buckets a command name into write, read, or admin.
+func commandGroup(command string) string {
+	switch {
+	case writeCommands[command]:
+		return "write"
+	case adminCommands[command]:
+		return "admin"
+	default:
+		return "read"
+	}
+}
+
+// fetchMetrics returns the current metrics snapshot, routing through the
+// exporter's cache when WithExporter configured one so the UI and any
+// concurrent Prometheus scrape share one GetMetrics INFO call per tick.
+// loadMetrics still runs its own INFO for the remaining fields, so the
+// view issues two INFO calls per tick either way.
+func (v *MonitorView) fetchMetrics() (*redis.Metrics, error) {
+	if v.exp != nil {
+		return v.exp.Metrics()
+	}
+	return v.redis.GetMetrics()
+}
+
 // loadMetrics loads and displays metrics
 func (v *MonitorView) loadMetrics() {
-	metrics, err := v.redis.GetMetrics()
+	if v.streaming {
+		return
+	}
+
+	metrics, err := v.fetchMetrics()
 	if err != nil {
 		v.component.SetText(fmt.Sprintf("[red]Error loading metrics: %s", err))
 		return
@@ -172,7 +679,7 @@ func (v *MonitorView) loadMetrics() {
 
 	if clusterEnabled == "1" {
 		// Get cluster nodes information
-		nodeTable = v.getClusterNodesTable()
+		nodeTable = v.getClusterNodesTable(context.Background())
 	} else {
 		// For standalone, show current node info
 		role := getInfoValue(info, "role", "master")
@@ -181,6 +688,19 @@ func (v *MonitorView) loadMetrics() {
 			"localhost", role, "127.0.0.1:6379", "connected")
 	}
 
+	haSection := ""
+	if v.sentinel != nil {
+		haSection = "\n[cyan]━━━ HA (Sentinel) ━━━[white]\n" + v.renderHASection(context.Background())
+	}
+
+	v.sampleCharts(info, metrics)
+	chartsSection := v.renderCharts()
+
+	eventsSection := ""
+	if v.eventsEnabled {
+		eventsSection = "\n[cyan]━━━ Events ━━━[white]\n" + v.renderEvents()
+	}
+
 	// Format metrics with enhanced information
 	metricsText := fmt.Sprintf(`[yellow]%s[white] - Redis Metrics:
 
@@ -190,36 +710,39 @@ func (v *MonitorView) loadMetrics() {
   [green]Rejected Connections:[white] %s
 
 [cyan]━━━ Memory Usage ━━━[white]
-  [green]Used Memory:[white] %s
   [green]Used Memory RSS:[white] %s
 
 [cyan]━━━ Command Statistics ━━━[white]
   [green]Total Commands:[white] %d
-  [green]Ops/sec:[white] %d
   [green]Keyspace Hits:[white] %d
   [green]Keyspace Misses:[white] %d
   [green]Hit Rate:[white] %.2f%%
 
+[cyan]━━━ Throughput & Latency ━━━[white]
+%s
 [cyan]━━━ Slow Queries Log ━━━[white]
   [green]Slow Log Length:[white] %s
 
 [cyan]━━━ Nodes ━━━[white]
 %s
+%s
+%s
 
 `,
 		timestamp,
 		metrics.ConnectedClients,
 		totalConnections,
 		rejectedConnections,
-		humanize.Bytes(uint64(metrics.UsedMemory)),
 		humanize.Bytes(uint64(metrics.UsedMemoryRss)),
 		metrics.TotalCommandsProcessed,
-		metrics.InstantaneousOpsPerSec,
 		metrics.KeyspaceHits,
 		metrics.KeyspaceMisses,
 		hitRate,
+		chartsSection,
 		slowlogLen,
 		nodeTable,
+		haSection,
+		eventsSection,
 	)
 
 	// Append to existing text
@@ -238,60 +761,730 @@ func (v *MonitorView) loadMetrics() {
 	v.component.ScrollToEnd()
 }
 
-// getClusterNodesTable returns formatted cluster nodes information
-func (v *MonitorView) getClusterNodesTable() string {
-	// Try to get cluster nodes information
-	result, err := v.redis.ClusterNodes()
-	if err != nil {
-		return "  [red]Error getting cluster nodes info[white]"
+// getClusterNodesTable fans INFO out across every cluster node (via
+// ClusterClient, caching one *redis.Client per address) and renders a row
+// per node. If a node is expanded, its detail pane is appended below the
+// table; its row stays in the table as well.
+func (v *MonitorView) getClusterNodesTable(ctx context.Context) string {
+	if v.clusterClient == nil {
+		v.clusterClient = redis.NewClusterClient(v.redis)
 	}
 
-	// Parse cluster nodes output
-	lines := splitLines(result)
-	if len(lines) == 0 {
+	nodes, err := v.clusterClient.NodeMetrics(ctx)
+	if err != nil {
+		return fmt.Sprintf("  [red]Error getting cluster nodes info: %s[white]", err)
+	}
+	if len(nodes) == 0 {
 		return "  [yellow]No cluster nodes found[white]"
 	}
 
-	table := fmt.Sprintf("  [green]%-40s %-10s %-20s %-10s[white]\n", "Node ID", "Role", "Host:Port", "Status")
-	table += fmt.Sprintf("  [green]%s[white]\n", "────────────────────────────────────────────────────────────────────────────────")
+	v.nodeMu.Lock()
+	v.nodeInfos = nodes
+	if v.selectedNode >= len(nodes) {
+		v.selectedNode = len(nodes) - 1
+	}
+	selectedNode := v.selectedNode
+	detailOpen := v.nodeDetailOpen
+	v.nodeMu.Unlock()
 
-	for _, line := range lines {
-		if line == "" {
+	header := fmt.Sprintf("  [green]%-3s %-10s %-10s %-20s %-14s %-10s %-12s %-10s %-10s[white]\n",
+		"", "Node ID", "Role", "Host:Port", "Used Mem", "Ops/sec", "Clients", "Hit Rate", "Link")
+	header += fmt.Sprintf("  [green]%s[white]\n", strings.Repeat("─", 100))
+
+	var b strings.Builder
+	b.WriteString(header)
+
+	for i, node := range nodes {
+		cursor := " "
+		if i == selectedNode {
+			cursor = ">"
+		}
+
+		status := node.LinkState
+		if status == "connected" {
+			status = "[green]connected[white]"
+		} else {
+			status = "[red]" + status + "[white]"
+		}
+
+		if node.Err != nil {
+			b.WriteString(fmt.Sprintf("  %-3s %-10s %-10s %-20s [red]error: %s[white]\n",
+				cursor, shortNodeID(node.ID), node.Role, node.Addr, node.Err))
 			continue
 		}
 
-		// Parse node line format: <id> <ip:port@cport> <flags> <master> <ping-sent> <pong-recv> <config-epoch> <link-state> <slot> <slot> ... <slot>
-		parts := strings.Fields(line)
-		if len(parts) < 8 {
+		hitRate := float64(0)
+		if node.Metrics.KeyspaceHits+node.Metrics.KeyspaceMisses > 0 {
+			hitRate = float64(node.Metrics.KeyspaceHits) / float64(node.Metrics.KeyspaceHits+node.Metrics.KeyspaceMisses) * 100
+		}
+
+		b.WriteString(fmt.Sprintf("  %-3s %-10s %-10s %-20s %-14s %-10d %-12d %-10.1f%s\n",
+			cursor, shortNodeID(node.ID), node.Role, node.Addr,
+			humanize.Bytes(uint64(node.Metrics.UsedMemory)),
+			node.Metrics.InstantaneousOpsPerSec,
+			node.Metrics.ConnectedClients,
+			hitRate, status))
+	}
+
+	if detailOpen && selectedNode < len(nodes) {
+		b.WriteString("\n")
+		b.WriteString(nodeDetailPane(nodes[selectedNode]))
+	}
+
+	return b.String()
+}
+
+// nodeDetailPane renders the expanded detail view for a single cluster
+// node, shown when the user presses Enter on a selected row.
+func nodeDetailPane(node redis.NodeInfo) string {
+	if node.Err != nil {
+		return fmt.Sprintf("  [red]%s: %s[white]", node.Addr, node.Err)
+	}
+
+	return fmt.Sprintf(`  [cyan]━━━ Node %s (%s) ━━━[white]
+  [green]Role:[white] %s
+  [green]Link State:[white] %s
+  [green]Connected Clients:[white] %d
+  [green]Used Memory:[white] %s
+  [green]Used Memory RSS:[white] %s
+  [green]Total Commands Processed:[white] %d
+  [green]Ops/sec:[white] %d
+  [green]Keyspace Hits:[white] %d
+  [green]Keyspace Misses:[white] %d`,
+		shortNodeID(node.ID), node.Addr,
+		node.Role, node.LinkState,
+		node.Metrics.ConnectedClients,
+		humanize.Bytes(uint64(node.Metrics.UsedMemory)),
+		humanize.Bytes(uint64(node.Metrics.UsedMemoryRss)),
+		node.Metrics.TotalCommandsProcessed,
+		node.Metrics.InstantaneousOpsPerSec,
+		node.Metrics.KeyspaceHits,
+		node.Metrics.KeyspaceMisses,
+	)
+}
+
+// sampleCharts pushes the current value of every chart metric into its
+// ring buffer: ops/sec and used memory from GetMetrics, net in/out from
+// INFO, and p99 latency computed from LATENCY HISTORY of whichever
+// tracked event currently has the worst all-time-max latency.
+func (v *MonitorView) sampleCharts(info map[string]interface{}, m *redis.Metrics) {
+	if v.charts == nil {
+		v.charts = metrics.NewStore(chartHistory)
+		v.primaryChart = chartOrder[0]
+	}
+
+	v.charts.Push("ops", float64(m.InstantaneousOpsPerSec))
+	v.charts.Push("mem", float64(m.UsedMemory))
+	v.charts.Push("net_in", infoFloat(info, "instantaneous_input_kbps"))
+	v.charts.Push("net_out", infoFloat(info, "instantaneous_output_kbps"))
+	v.charts.Push("p99", v.sampleP99Latency())
+}
+
+// sampleP99Latency picks the tracked LATENCY LATEST event with the worst
+// all-time-max latency and returns the p99 of its LATENCY HISTORY samples.
+func (v *MonitorView) sampleP99Latency() float64 {
+	ctx := context.Background()
+
+	events, err := v.redis.LatencyLatest(ctx)
+	if err != nil || len(events) == 0 {
+		return 0
+	}
+
+	worst := events[0]
+	for _, event := range events[1:] {
+		if event.MaxMS > worst.MaxMS {
+			worst = event
+		}
+	}
+
+	samples, err := v.redis.LatencyHistory(ctx, worst.Name)
+	if err != nil {
+		return 0
+	}
+
+	return float64(redis.Percentile(samples, 99))
+}
+
+// renderCharts draws a sparkline plus min/max/avg annotations for every
+// chart metric, highlighting the currently selected primary chart.
+func (v *MonitorView) renderCharts() string {
+	if v.charts == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, key := range chartOrder {
+		rb := v.charts.Get(key)
+		if rb == nil {
 			continue
 		}
 
-		nodeID := parts[0][:8] + "..."              // Truncate node ID for display
-		hostPort := strings.Split(parts[1], "@")[0] // Remove cluster port
-		flags := parts[2]
-		linkState := parts[7]
+		marker := " "
+		label := chartLabels[key]
+		if key == v.primaryChart {
+			marker = "*"
+			label = "[white::b]" + label + "[-:-:-]"
+		}
+
+		min, max, avg := rb.Stats()
+		b.WriteString(fmt.Sprintf("  %s [green]%-20s[white] %s  [yellow]min %.0f max %.0f avg %.0f[white]\n",
+			marker, label, sparkline(rb.Values()), min, max, avg))
+	}
 
-		// Determine role from flags
-		role := "slave"
-		if strings.Contains(flags, "master") {
-			role = "master"
+	return b.String()
+}
+
+// cyclePrimaryChart moves the primary chart selection by delta, wrapping
+// around chartOrder.
+func (v *MonitorView) cyclePrimaryChart(delta int) {
+	idx := 0
+	for i, key := range chartOrder {
+		if key == v.primaryChart {
+			idx = i
+			break
 		}
-		if strings.Contains(flags, "myself") {
-			role += " (self)"
+	}
+
+	idx = (idx + delta + len(chartOrder)) % len(chartOrder)
+	v.primaryChart = chartOrder[idx]
+	v.Refresh()
+}
+
+// resetLatencyHistogram runs LATENCY RESET against Redis.
+func (v *MonitorView) resetLatencyHistogram() {
+	if err := v.redis.LatencyReset(context.Background()); err != nil {
+		logger.Logger.Printf("LATENCY RESET failed: %v", err)
+	}
+	v.Refresh()
+}
+
+// infoFloat parses an INFO field as a float64, defaulting to 0.
+func infoFloat(info map[string]interface{}, key string) float64 {
+	s := getInfoValue(info, key, "0")
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
+// toggleSlowlogView swaps between the metrics dashboard and the slow-log
+// table, starting or stopping its independent poll timer accordingly.
+func (v *MonitorView) toggleSlowlogView() {
+	if v.slowlogMode {
+		v.stopSlowlogPolling()
+		v.slowlogMode = false
+		v.pages.SwitchToPage("metrics")
+		return
+	}
+
+	v.slowlogMode = true
+	v.pages.SwitchToPage("slowlog")
+	v.startSlowlogPolling()
+}
+
+// startSlowlogPolling fetches SLOWLOG GET immediately, then keeps scraping
+// it on v.slowlogInterval — a timer kept separate from the main 1s metrics
+// ticker so slowlog scraping doesn't hammer the server.
+func (v *MonitorView) startSlowlogPolling() {
+	v.refreshSlowlog()
+
+	v.slowlogStopChan = make(chan bool)
+	v.slowlogTicker = time.NewTicker(v.slowlogInterval)
+
+	go func() {
+		for {
+			select {
+			case <-v.slowlogTicker.C:
+				v.refreshSlowlog()
+			case <-v.slowlogStopChan:
+				return
+			}
 		}
+	}()
+}
 
-		// Color code status
-		status := linkState
-		if linkState == "connected" {
-			status = "[green]connected[white]"
-		} else {
-			status = "[red]" + linkState + "[white]"
+// stopSlowlogPolling stops the slow-log poll timer, if running.
+func (v *MonitorView) stopSlowlogPolling() {
+	if v.slowlogTicker != nil {
+		v.slowlogTicker.Stop()
+		v.slowlogTicker = nil
+	}
+	if v.slowlogStopChan != nil {
+		close(v.slowlogStopChan)
+		v.slowlogStopChan = nil
+	}
+}
+
+// refreshSlowlog re-fetches SLOWLOG GET and redraws the table.
+func (v *MonitorView) refreshSlowlog() {
+	entries, err := v.redis.SlowLogGet(context.Background(), slowlogFetchCount)
+	if err != nil {
+		logger.Logger.Printf("SLOWLOG GET failed: %v", err)
+		return
+	}
+
+	v.slowlogMu.Lock()
+	if v.slowlogSortDesc {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].DurationUS > entries[j].DurationUS })
+	}
+	v.slowlogEntries = entries
+	v.slowlogMu.Unlock()
+
+	v.renderSlowlogTable()
+}
+
+// renderSlowlogTable rebuilds the slow-log tview.Table from v.slowlogEntries.
+func (v *MonitorView) renderSlowlogTable() {
+	v.slowlogMu.Lock()
+	entries := append([]redis.SlowLogEntry(nil), v.slowlogEntries...)
+	sortDesc := v.slowlogSortDesc
+	v.slowlogMu.Unlock()
+
+	table := v.slowlogTable
+	table.Clear()
+
+	headers := []string{"ID", "Time", "Duration (µs)", "Client", "Command"}
+	for col, header := range headers {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.ColorGreen).
+			SetSelectable(false).
+			SetAttributes(tcell.AttrBold))
+	}
+
+	for row, entry := range entries {
+		table.SetCell(row+1, 0, tview.NewTableCell(fmt.Sprintf("%d", entry.ID)))
+		table.SetCell(row+1, 1, tview.NewTableCell(time.Unix(entry.Timestamp, 0).Format("15:04:05")))
+		table.SetCell(row+1, 2, tview.NewTableCell(fmt.Sprintf("%d", entry.DurationUS)))
+		table.SetCell(row+1, 3, tview.NewTableCell(entry.ClientAddr))
+		table.SetCell(row+1, 4, tview.NewTableCell(truncateCommand(entry.Args, 60)))
+	}
+
+	title := fmt.Sprintf("Slow Log (%d entries, poll %s)", len(entries), v.slowlogInterval)
+	if sortDesc {
+		title += " [sorted by duration]"
+	}
+	table.SetTitle(title)
+}
+
+// handleSlowlogInput handles input while the slow-log table is focused.
+func (v *MonitorView) handleSlowlogInput(event *tcell.EventKey) *tcell.EventKey {
+	if v.thresholdEditing {
+		return v.handleThresholdInput(event)
+	}
+
+	switch event.Rune() {
+	case 'l':
+		v.toggleSlowlogView()
+		return nil
+	case 'd', 'D':
+		v.slowlogMu.Lock()
+		v.slowlogSortDesc = !v.slowlogSortDesc
+		v.slowlogMu.Unlock()
+		v.refreshSlowlog()
+		return nil
+	case 'X':
+		v.resetSlowlog()
+		return nil
+	case 't':
+		v.openThresholdEditor()
+		return nil
+	}
+
+	if event.Key() == tcell.KeyEnter {
+		v.showSlowlogDetail()
+		return nil
+	}
+
+	// Let Up/Down/PageUp/PageDown pass through to the table's own navigation.
+	return event
+}
+
+// resetSlowlog runs SLOWLOG RESET and refreshes the table.
+func (v *MonitorView) resetSlowlog() {
+	if err := v.redis.SlowLogReset(context.Background()); err != nil {
+		logger.Logger.Printf("SLOWLOG RESET failed: %v", err)
+		return
+	}
+	v.refreshSlowlog()
+}
+
+// showSlowlogDetail opens a modal with the full command and client info for
+// the selected slow-log row.
+func (v *MonitorView) showSlowlogDetail() {
+	row, _ := v.slowlogTable.GetSelection()
+	idx := row - 1
+
+	v.slowlogMu.Lock()
+	if idx < 0 || idx >= len(v.slowlogEntries) {
+		v.slowlogMu.Unlock()
+		return
+	}
+	entry := v.slowlogEntries[idx]
+	v.slowlogMu.Unlock()
+	text := fmt.Sprintf("ID: %d\nTime: %s\nDuration: %d µs\nClient: %s (%s)\nCommand: %s",
+		entry.ID,
+		time.Unix(entry.Timestamp, 0).Format("2006-01-02 15:04:05"),
+		entry.DurationUS,
+		entry.ClientAddr, entry.ClientName,
+		strings.Join(entry.Args, " "))
+
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"Close"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			v.pages.RemovePage("slowlog-detail")
+		})
+
+	v.pages.AddPage("slowlog-detail", modal, true, true)
+}
+
+// openThresholdEditor opens an input field to CONFIG SET
+// slowlog-log-slower-than, pre-filled with the current value.
+func (v *MonitorView) openThresholdEditor() {
+	v.thresholdEditing = true
+
+	current, err := v.redis.ConfigGet(context.Background(), "slowlog-log-slower-than")
+	if err != nil {
+		current = ""
+	}
+	v.thresholdBuf = current
+
+	input := tview.NewInputField().
+		SetLabel("slowlog-log-slower-than (µs): ").
+		SetText(current).
+		SetFieldWidth(10)
+
+	input.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			if err := v.redis.ConfigSet(context.Background(), "slowlog-log-slower-than", input.GetText()); err != nil {
+				logger.Logger.Printf("CONFIG SET slowlog-log-slower-than failed: %v", err)
+			}
+		}
+		v.thresholdEditing = false
+		v.pages.RemovePage("slowlog-threshold")
+	})
+
+	input.SetBorder(true).SetTitle("Slow Log Threshold")
+	v.pages.AddPage("slowlog-threshold", centered(input, 50, 3), true, true)
+}
+
+// handleThresholdInput is a no-op placeholder: the threshold editor is a
+// focused tview.InputField, so keystrokes go directly to it rather than
+// through this view's input capture.
+func (v *MonitorView) handleThresholdInput(event *tcell.EventKey) *tcell.EventKey {
+	return event
+}
+
+// truncateCommand joins a SLOWLOG command's args and truncates it for
+// table display.
+func truncateCommand(args []string, max int) string {
+	full := strings.Join(args, " ")
+	if len(full) <= max {
+		return full
+	}
+	return full[:max] + "…"
+}
+
+// centered wraps p in nested flex boxes so it renders as a fixed-size
+// width x height box in the middle of the screen.
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// toggleEvents enables or disables the keyspace-notifications Events panel.
+// Enabling requires confirmation since it flips a server-wide config
+// setting; disabling restores whatever value was active beforehand.
+func (v *MonitorView) toggleEvents() {
+	if v.eventsEnabled {
+		v.disableEvents()
+		return
+	}
+
+	v.confirmEnableEvents()
+}
+
+// confirmEnableEvents prompts before setting notify-keyspace-events, since
+// it's a server-wide config change that affects every client.
+func (v *MonitorView) confirmEnableEvents() {
+	modal := tview.NewModal().
+		SetText("Enable keyspace notifications?\nThis runs CONFIG SET notify-keyspace-events KEA.\nThe prior value is restored when the Events panel is closed.").
+		AddButtons([]string{"Enable", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			v.pages.RemovePage("events-confirm")
+			if buttonLabel == "Enable" {
+				v.enableEvents()
+			}
+		})
+
+	v.pages.AddPage("events-confirm", centered(modal, 60, 9), true, true)
+}
+
+// enableEvents records the current notify-keyspace-events value, sets it to
+// KEA, and starts tailing keyspace/keyevent notifications.
+func (v *MonitorView) enableEvents() {
+	ctx := context.Background()
+
+	prev, err := v.redis.ConfigGet(ctx, "notify-keyspace-events")
+	if err != nil {
+		logger.Logger.Printf("CONFIG GET notify-keyspace-events failed: %v", err)
+		return
+	}
+
+	if err := v.redis.ConfigSet(ctx, "notify-keyspace-events", "KEA"); err != nil {
+		logger.Logger.Printf("CONFIG SET notify-keyspace-events failed: %v", err)
+		return
+	}
+
+	eventsCtx, cancel := context.WithCancel(ctx)
+	msgs, err := v.redis.PSubscribe(eventsCtx, "__keyevent@*__:*", "__keyspace@*__:*")
+	if err != nil {
+		cancel()
+		logger.Logger.Printf("PSubscribe failed: %v", err)
+		return
+	}
+
+	v.eventsEnabled = true
+	v.eventsPrevValue = prev
+	v.eventsCancel = cancel
+
+	go func() {
+		for {
+			select {
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				v.handleEventMessage(msg)
+			case <-eventsCtx.Done():
+				return
+			}
 		}
+	}()
+}
 
-		table += fmt.Sprintf("  %-40s %-10s %-20s %s\n", nodeID, role, hostPort, status)
+// disableEvents stops the event tail and restores the notify-keyspace-events
+// value that was active before the Events panel was enabled.
+func (v *MonitorView) disableEvents() {
+	if v.eventsCancel != nil {
+		v.eventsCancel()
+		v.eventsCancel = nil
 	}
 
-	return table
+	if err := v.redis.ConfigSet(context.Background(), "notify-keyspace-events", v.eventsPrevValue); err != nil {
+		logger.Logger.Printf("restoring notify-keyspace-events failed: %v", err)
+	}
+
+	v.eventsEnabled = false
+
+	v.eventsMu.Lock()
+	v.eventsPaused = false
+	v.eventLines = nil
+	v.eventCounts = nil
+	v.eventsMu.Unlock()
+}
+
+// handleEventMessage parses a __keyevent@<db>__:<event> message (ignoring
+// the mirrored __keyspace@ channel, which would otherwise double-count
+// every notification) and records it if it's not paused and matches the
+// active key glob.
+func (v *MonitorView) handleEventMessage(msg redis.PubSubMessage) {
+	if !strings.HasPrefix(msg.Channel, "__keyevent@") {
+		return
+	}
+
+	rest := strings.TrimPrefix(msg.Channel, "__keyevent@")
+	parts := strings.SplitN(rest, "__:", 2)
+	if len(parts) != 2 {
+		return
+	}
+	db, eventType, key := parts[0], parts[1], msg.Payload
+
+	now := time.Now()
+
+	v.eventsMu.Lock()
+	if v.eventsPaused {
+		v.eventsMu.Unlock()
+		return
+	}
+	if v.eventGlob != "" {
+		if ok, _ := path.Match(v.eventGlob, key); !ok {
+			v.eventsMu.Unlock()
+			return
+		}
+	}
+
+	v.eventLines = append(v.eventLines, eventRecord{At: now, DB: db, Event: eventType, Key: key})
+	if len(v.eventLines) > eventScrollback {
+		v.eventLines = v.eventLines[len(v.eventLines)-eventScrollback:]
+	}
+	v.eventCounts = append(v.eventCounts, eventCountSample{At: now, Event: eventType})
+	v.eventsMu.Unlock()
+
+	v.Refresh()
+}
+
+// renderEvents draws the Events panel: pause/filter state, per-event-type
+// counters over the last eventCountWindow, and the most recent rows.
+func (v *MonitorView) renderEvents() string {
+	v.eventsMu.Lock()
+	lines := append([]eventRecord(nil), v.eventLines...)
+	counts := append([]eventCountSample(nil), v.eventCounts...)
+	paused := v.eventsPaused
+	globEditing := v.eventGlobEditing
+	globBuf := v.eventGlobBuf
+	glob := v.eventGlob
+	v.eventsMu.Unlock()
+
+	cutoff := time.Now().Add(-eventCountWindow)
+	totals := map[string]int{}
+	for _, sample := range counts {
+		if sample.At.After(cutoff) {
+			totals[sample.Event]++
+		}
+	}
+
+	var b strings.Builder
+
+	if paused {
+		b.WriteString("  [yellow]PAUSED[white]\n")
+	}
+	if globEditing {
+		b.WriteString(fmt.Sprintf("  [yellow]glob: %s[white]\n", globBuf))
+	} else if glob != "" {
+		b.WriteString(fmt.Sprintf("  [yellow]glob: %s[white]\n", glob))
+	}
+
+	if len(totals) > 0 {
+		names := make([]string, 0, len(totals))
+		for name := range totals {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		b.WriteString("  [green]Last 60s:[white] ")
+		for _, name := range names {
+			b.WriteString(fmt.Sprintf("%s=%d ", name, totals[name]))
+		}
+		b.WriteString("\n")
+	}
+
+	start := 0
+	if len(lines) > 20 {
+		start = len(lines) - 20
+	}
+	for _, rec := range lines[start:] {
+		b.WriteString(fmt.Sprintf("  %s [green]db%s[white] %-10s %s\n", rec.At.Format("15:04:05"), rec.DB, rec.Event, rec.Key))
+	}
+
+	return b.String()
+}
+
+// EnableHA wires up a Sentinel-backed "HA" section: it rotates across
+// sentinelAddrs watching masterName and subscribes to the Sentinel failover
+// pub/sub channels for as long as the view is alive.
+func (v *MonitorView) EnableHA(masterName string, sentinelAddrs []string, password string) error {
+	sentinel, err := redis.NewSentinelClient(masterName, sentinelAddrs, password)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.sentinel = sentinel
+	v.haCancel = cancel
+
+	events := sentinel.Events(ctx)
+	go func() {
+		for event := range events {
+			v.appendHAEvent(event)
+		}
+	}()
+
+	return nil
+}
+
+// DisableHA stops the Sentinel event feed, if one is running.
+func (v *MonitorView) DisableHA() {
+	if v.haCancel != nil {
+		v.haCancel()
+		v.haCancel = nil
+	}
+	v.sentinel = nil
+}
+
+// appendHAEvent records a Sentinel event line, bounded to haEventScrollback.
+func (v *MonitorView) appendHAEvent(event redis.SentinelEvent) {
+	v.haTopologyMu.Lock()
+	defer v.haTopologyMu.Unlock()
+
+	line := fmt.Sprintf("[red]%s[white] %s %s", event.At.Format("15:04:05"), event.Channel, event.Payload)
+	v.haEvents = append(v.haEvents, line)
+	if len(v.haEvents) > haEventScrollback {
+		v.haEvents = v.haEvents[len(v.haEvents)-haEventScrollback:]
+	}
+}
+
+// renderHASection polls the Sentinel topology and renders it alongside the
+// most recent HA events.
+func (v *MonitorView) renderHASection(ctx context.Context) string {
+	topology, err := v.sentinel.Topology(ctx)
+	if err != nil {
+		return fmt.Sprintf("  [red]Error polling Sentinel: %s[white]", err)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("  [green]%-20s %-10s %-10s %-10s[white]\n", "Master Addr", "Slaves", "Quorum", "Last OK Ping"))
+	for _, status := range topology {
+		b.WriteString(fmt.Sprintf("  %-20s %-10d %-10d %-10d\n", status.Addr, status.NumSlaves, status.Quorum, status.LastOKPing))
+	}
+
+	v.haTopologyMu.Lock()
+	events := append([]string(nil), v.haEvents...)
+	v.haTopologyMu.Unlock()
+
+	if len(events) > 0 {
+		b.WriteString("\n")
+		for _, line := range events {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// moveNodeSelection moves the selected cluster node row by delta, clamped
+// to the known node list, and refreshes the detail pane if it's open.
+func (v *MonitorView) moveNodeSelection(delta int) {
+	v.nodeMu.Lock()
+	if len(v.nodeInfos) == 0 {
+		v.nodeMu.Unlock()
+		return
+	}
+
+	v.selectedNode += delta
+	if v.selectedNode < 0 {
+		v.selectedNode = 0
+	}
+	if v.selectedNode >= len(v.nodeInfos) {
+		v.selectedNode = len(v.nodeInfos) - 1
+	}
+	detailOpen := v.nodeDetailOpen
+	v.nodeMu.Unlock()
+
+	if detailOpen {
+		v.Refresh()
+	}
+}
+
+// shortNodeID truncates a cluster node ID for table display.
+func shortNodeID(id string) string {
+	if len(id) <= 8 {
+		return id
+	}
+	return id[:8] + "..."
 }
 
 // getInfoValue safely extracts a value from info map
@@ -0,0 +1,38 @@
+package ui
+
+import "strings"
+
+// sparkBars are the Unicode block characters used to render a sparkline,
+// lowest to highest.
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders values as a single line of Unicode bars scaled between
+// their own min and max.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	span := max - min
+
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if span > 0 {
+			idx = int((v - min) / span * float64(len(sparkBars)-1))
+		}
+		b.WriteRune(sparkBars[idx])
+	}
+
+	return b.String()
+}